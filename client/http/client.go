@@ -1,15 +1,25 @@
 package http
 
 import (
+	"context"
 	"crypto/tls"
+	"fmt"
 	"net"
 	"net/http"
+	"runtime/debug"
+	"strings"
 	"time"
 
 	core "github.com/elliottech/lighter-go/client"
 )
 
+const modulePath = "github.com/elliottech/lighter-go"
+
 var (
+	// Version of the SDK, sent in the User-Agent header. It's empty by default & set at build time by the justfile builds,
+	// using -ldflags "-X github.com/elliottech/lighter-go/client/http.Version=...".
+	Version = ""
+
 	dialer = &net.Dialer{
 		Timeout:   10 * time.Second,
 		KeepAlive: 60 * time.Second,
@@ -31,18 +41,106 @@ var (
 	}
 )
 
-var _ core.MinimalHTTPClient = (*client)(nil)
+// Client is the HTTP client returned by NewClient.
+// Besides the methods required by the TxClient, it exposes Do, for endpoints which are not wrapped by the SDK.
+type Client interface {
+	core.MinimalHTTPClient
+	Do(ctx context.Context, req *Request, result interface{}) error
+}
+
+var _ Client = (*client)(nil)
 
 type client struct {
-	endpoint string
+	endpoint  string
+	appName   string
+	userAgent string // empty if telemetry is disabled
+}
+
+// Option configures the client created by NewClient
+type Option func(c *client)
+
+// WithAppName adds the name of the application using the SDK to the User-Agent header,
+// so Lighter support can identify the integration during incidents.
+// Characters which are not allowed in a header token (spaces, CR/LF, ...) are dropped.
+func WithAppName(appName string) Option {
+	return func(c *client) {
+		c.appName = appName
+	}
+}
+
+// WithoutTelemetry disables the client identification headers.
+// Requests are sent with the default User-Agent of the go HTTP client.
+func WithoutTelemetry() Option {
+	return func(c *client) {
+		c.userAgent = ""
+	}
 }
 
-func NewClient(baseUrl string) core.MinimalHTTPClient {
+func NewClient(baseUrl string, opts ...Option) Client {
 	if baseUrl == "" {
 		return nil
 	}
 
-	return &client{
-		endpoint: baseUrl,
+	c := &client{
+		endpoint:  baseUrl,
+		userAgent: "lighter-go/" + SDKVersion(),
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	if appName := sanitizeAppName(c.appName); c.userAgent != "" && appName != "" {
+		c.userAgent = fmt.Sprintf("%s %s", c.userAgent, appName)
+	}
+
+	return c
+}
+
+// SDKVersion returns the version of the lighter-go module sent in the User-Agent header.
+// It's Version if set at build time, otherwise the version recorded in the build info of the running binary,
+// which is available when lighter-go is used as a dependency. Returns "unknown" if neither provides a valid version.
+func SDKVersion() string {
+	version := Version
+	if version == "" {
+		version = buildInfoVersion()
+	}
+	// builds from within this repository report "(devel)", which is not a valid product version
+	if version == "" || strings.IndexFunc(version, func(r rune) bool { return !isTokenChar(r) }) != -1 {
+		return "unknown"
+	}
+	return version
+}
+
+func buildInfoVersion() string {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return ""
+	}
+	if info.Main.Path == modulePath {
+		return info.Main.Version
+	}
+	for _, dep := range info.Deps {
+		if dep.Path == modulePath {
+			return dep.Version
+		}
+	}
+	return ""
+}
+
+// sanitizeAppName drops all characters which are not allowed in a User-Agent product token, except for '/',
+// so that names like "my-app/1.2.0" are kept, while CR/LF or spaces can't break the header.
+func sanitizeAppName(appName string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '/' || isTokenChar(r) {
+			return r
+		}
+		return -1
+	}, appName)
+}
+
+// isTokenChar reports whether r is a tchar, as defined in RFC 9110
+func isTokenChar(r rune) bool {
+	if r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' {
+		return true
 	}
+	return strings.ContainsRune("!#$%&'*+-.^_`|~", r)
 }
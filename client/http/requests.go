@@ -1,12 +1,14 @@
 package http
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
+	"strings"
 )
 
 func (c *client) parseResultStatus(respBody []byte) error {
@@ -20,39 +22,87 @@ func (c *client) parseResultStatus(respBody []byte) error {
 	return nil
 }
 
-func (c *client) getAndParseL2HTTPResponse(path string, params map[string]any, result interface{}) error {
+// Request describes a call to a Lighter HTTP endpoint.
+// Params are sent as query parameters for GET requests and as a form-encoded body otherwise.
+type Request struct {
+	Method string // defaults to GET
+	Path   string
+	Params map[string]any
+}
+
+// Do sends the request & parses the response into result, the same way the wrapped endpoints do.
+// It can be used to call endpoints which are not wrapped by the SDK yet. result can be nil if the body is not needed.
+func (c *client) Do(ctx context.Context, req *Request, result interface{}) error {
+	if req == nil {
+		return fmt.Errorf("request is nil")
+	}
+
 	u, err := url.Parse(c.endpoint)
 	if err != nil {
 		return err
 	}
-	u.Path = path
+	u.Path = req.Path
 
-	q := u.Query()
-	for k, v := range params {
-		q.Set(k, fmt.Sprintf("%v", v))
+	method := req.Method
+	if method == "" {
+		method = http.MethodGet
 	}
-	u.RawQuery = q.Encode()
-	resp, err := httpClient.Get(u.String())
+
+	// GET params are merged into the query of the endpoint, so any query it already has is kept
+	values := u.Query()
+	if method != http.MethodGet {
+		values = url.Values{}
+	}
+	for k, v := range req.Params {
+		values.Set(k, fmt.Sprintf("%v", v))
+	}
+
+	var body io.Reader
+	if method == http.MethodGet {
+		u.RawQuery = values.Encode()
+	} else {
+		body = strings.NewReader(values.Encode())
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, method, u.String(), body)
+	if err != nil {
+		return err
+	}
+	if body != nil {
+		httpReq.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	}
+	if c.userAgent != "" {
+		httpReq.Header.Set("User-Agent", c.userAgent)
+	}
+
+	resp, err := httpClient.Do(httpReq)
 	if err != nil {
 		return err
 	}
 	defer resp.Body.Close()
-	body, err := io.ReadAll(resp.Body)
+	respBody, err := io.ReadAll(resp.Body)
 	if err != nil {
 		return err
 	}
 	if resp.StatusCode != http.StatusOK {
-		return errors.New(string(body))
+		return errors.New(string(respBody))
 	}
-	if err = c.parseResultStatus(body); err != nil {
+	if err = c.parseResultStatus(respBody); err != nil {
 		return err
 	}
-	if err := json.Unmarshal(body, result); err != nil {
+	if result == nil {
+		return nil
+	}
+	if err := json.Unmarshal(respBody, result); err != nil {
 		return err
 	}
 	return nil
 }
 
+func (c *client) getAndParseL2HTTPResponse(path string, params map[string]any, result interface{}) error {
+	return c.Do(context.Background(), &Request{Path: path, Params: params}, result)
+}
+
 func (c *client) GetNextNonce(accountIndex int64, apiKeyIndex uint8) (int64, error) {
 	result := &NextNonce{}
 	err := c.getAndParseL2HTTPResponse("api/v1/nextNonce", map[string]any{"account_index": accountIndex, "api_key_index": apiKeyIndex}, result)
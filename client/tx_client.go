@@ -13,12 +13,15 @@ var (
 	// DefaultExpireTime is a public var, so it can be changed directly in the SDK if required.
 	// The encouraged behaviour is the manually specify the TX deadline in types.TransactOpts.ExpiredAt
 	DefaultExpireTime = time.Minute*10 - time.Second // we need to give a second margin, to eliminate millisecond differences
+
+	// ErrSignerRequired is returned by every method which needs to sign, when the TxClient was created without a private key.
+	ErrSignerRequired = fmt.Errorf("signer is required. TxClient was created in read-only mode, without a private key")
 )
 
 type TxClient struct {
 	apiClient    MinimalHTTPClient
 	chainId      uint32
-	keyManager   signer.KeyManager
+	keyManager   signer.KeyManager // nil for read-only clients
 	accountIndex int64
 	apiKeyIndex  uint8
 }
@@ -53,6 +56,24 @@ func NewTxClient(apiClient MinimalHTTPClient, apiKeyPrivateKey string, accountIn
 	}, nil
 }
 
+// NewReadOnlyTxClient creates a TxClient which holds no key material.
+// It can be used for observational purposes only (HTTP queries); all signing methods return ErrSignerRequired.
+// accountIndex and apiKeyIndex are only used by account-specific queries, so they can be 0 for public endpoints.
+// The client is not stored, so it's never returned by GetClient and never replaces the default client.
+func NewReadOnlyTxClient(apiClient MinimalHTTPClient, accountIndex int64, apiKeyIndex uint8, chainId uint32) *TxClient {
+	return &TxClient{
+		apiClient:    apiClient,
+		apiKeyIndex:  apiKeyIndex,
+		accountIndex: accountIndex,
+		chainId:      chainId,
+	}
+}
+
+// IsReadOnly returns true if the client has no signer attached
+func (c *TxClient) IsReadOnly() bool {
+	return c.keyManager == nil
+}
+
 // FullFillDefaultOps returns a usable TransactOpts object if none was provided.
 // This should not the be case for sharedlib, except for the nonce, which is optional.
 // Still, the behaviour is implemented, so it can be extended easily by extending the code GO SDK.
@@ -87,6 +108,7 @@ func (c *TxClient) GetChainId() uint32 {
 	return c.chainId
 }
 
+// GetKeyManager returns nil for read-only clients
 func (c *TxClient) GetKeyManager() signer.KeyManager {
 	return c.keyManager
 }
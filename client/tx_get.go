@@ -11,6 +11,9 @@ import (
 )
 
 func (c *TxClient) GetAuthToken(deadline time.Time) (string, error) {
+	if c.IsReadOnly() {
+		return "", ErrSignerRequired
+	}
 	return types.ConstructAuthToken(c.keyManager, deadline, &types.TransactOpts{
 		ApiKeyIndex:      &c.apiKeyIndex,
 		FromAccountIndex: &c.accountIndex,
@@ -18,6 +21,9 @@ func (c *TxClient) GetAuthToken(deadline time.Time) (string, error) {
 }
 
 func (c *TxClient) GetChangePubKeyTransaction(tx *types.ChangePubKeyReq, ops *types.TransactOpts) (*txtypes.L2ChangePubKeyTxInfo, error) {
+	if c.IsReadOnly() {
+		return nil, ErrSignerRequired
+	}
 	ops, err := c.FullFillDefaultOps(ops)
 	if err != nil {
 		return nil, err
@@ -38,6 +44,9 @@ func (c *TxClient) GetChangePubKeyTransaction(tx *types.ChangePubKeyReq, ops *ty
 }
 
 func (c *TxClient) GetCreateSubAccountTransaction(ops *types.TransactOpts) (*txtypes.L2CreateSubAccountTxInfo, error) {
+	if c.IsReadOnly() {
+		return nil, ErrSignerRequired
+	}
 	ops, err := c.FullFillDefaultOps(ops)
 	if err != nil {
 		return nil, err
@@ -50,6 +59,9 @@ func (c *TxClient) GetCreateSubAccountTransaction(ops *types.TransactOpts) (*txt
 }
 
 func (c *TxClient) GetCreatePublicPoolTransaction(tx *types.CreatePublicPoolTxReq, ops *types.TransactOpts) (*txtypes.L2CreatePublicPoolTxInfo, error) {
+	if c.IsReadOnly() {
+		return nil, ErrSignerRequired
+	}
 	ops, err := c.FullFillDefaultOps(ops)
 	if err != nil {
 		return nil, err
@@ -62,6 +74,9 @@ func (c *TxClient) GetCreatePublicPoolTransaction(tx *types.CreatePublicPoolTxRe
 }
 
 func (c *TxClient) GetUpdatePublicPoolTransaction(tx *types.UpdatePublicPoolTxReq, ops *types.TransactOpts) (*txtypes.L2UpdatePublicPoolTxInfo, error) {
+	if c.IsReadOnly() {
+		return nil, ErrSignerRequired
+	}
 	ops, err := c.FullFillDefaultOps(ops)
 	if err != nil {
 		return nil, err
@@ -74,6 +89,9 @@ func (c *TxClient) GetUpdatePublicPoolTransaction(tx *types.UpdatePublicPoolTxRe
 }
 
 func (c *TxClient) GetTransferTransaction(tx *types.TransferTxReq, ops *types.TransactOpts) (*txtypes.L2TransferTxInfo, error) {
+	if c.IsReadOnly() {
+		return nil, ErrSignerRequired
+	}
 	ops, err := c.FullFillDefaultOps(ops)
 	if err != nil {
 		return nil, err
@@ -86,6 +104,9 @@ func (c *TxClient) GetTransferTransaction(tx *types.TransferTxReq, ops *types.Tr
 }
 
 func (c *TxClient) GetWithdrawTransaction(tx *types.WithdrawTxReq, ops *types.TransactOpts) (*txtypes.L2WithdrawTxInfo, error) {
+	if c.IsReadOnly() {
+		return nil, ErrSignerRequired
+	}
 	ops, err := c.FullFillDefaultOps(ops)
 	if err != nil {
 		return nil, err
@@ -99,6 +120,9 @@ func (c *TxClient) GetWithdrawTransaction(tx *types.WithdrawTxReq, ops *types.Tr
 }
 
 func (c *TxClient) GetCreateOrderTransaction(tx *types.CreateOrderTxReq, ops *types.TransactOpts) (*txtypes.L2CreateOrderTxInfo, error) {
+	if c.IsReadOnly() {
+		return nil, ErrSignerRequired
+	}
 	ops, err := c.FullFillDefaultOps(ops)
 	if err != nil {
 		return nil, err
@@ -110,7 +134,79 @@ func (c *TxClient) GetCreateOrderTransaction(tx *types.CreateOrderTxReq, ops *ty
 	return txInfo, nil
 }
 
+// GetCreateReduceOnlyOrderTransaction signs tx as a reduce-only order, after validating it locally against position,
+// the signed base amount currently held in tx.MarketIndex (positive for long, negative for short).
+// The validation happens before the nonce is fetched & the tx is signed. tx is not modified.
+func (c *TxClient) GetCreateReduceOnlyOrderTransaction(tx *types.CreateOrderTxReq, position int64, ops *types.TransactOpts) (*txtypes.L2CreateOrderTxInfo, error) {
+	if c.IsReadOnly() {
+		return nil, ErrSignerRequired
+	}
+	reduceOnlyTx := *tx
+	reduceOnlyTx.ReduceOnly = 1
+
+	if err := validateReduceOnly(&reduceOnlyTx, position); err != nil {
+		return nil, err
+	}
+	return c.GetCreateOrderTransaction(&reduceOnlyTx, ops)
+}
+
+// GetCreateGroupedOrdersTransactionWithPosition signs tx like GetCreateGroupedOrdersTransaction, after validating its
+// reduce-only orders locally against position, the signed base amount currently held in the market of the orders.
+// For OCO groups both orders act on the current position, so both are checked against it. For OTO and OTOCO groups
+// the parent is checked against the current position, while the children are checked against the position left
+// once the parent is filled, as they only become active then.
+func (c *TxClient) GetCreateGroupedOrdersTransactionWithPosition(tx *types.CreateGroupedOrdersTxReq, position int64, ops *types.TransactOpts) (*txtypes.L2CreateGroupedOrdersTxInfo, error) {
+	if c.IsReadOnly() {
+		return nil, ErrSignerRequired
+	}
+
+	if tx.GroupingType == txtypes.GroupingType_OneCancelsTheOther || len(tx.Orders) == 0 {
+		for _, order := range tx.Orders {
+			if err := validateReduceOnly(order, position); err != nil {
+				return nil, err
+			}
+		}
+		return c.GetCreateGroupedOrdersTransaction(tx, ops)
+	}
+
+	parent := tx.Orders[0]
+	if err := validateReduceOnly(parent, position); err != nil {
+		return nil, err
+	}
+	filledPosition := positionAfterFill(parent, position)
+	for _, order := range tx.Orders[1:] {
+		if err := validateReduceOnly(order, filledPosition); err != nil {
+			return nil, err
+		}
+	}
+	return c.GetCreateGroupedOrdersTransaction(tx, ops)
+}
+
+func validateReduceOnly(tx *types.CreateOrderTxReq, position int64) error {
+	order := &txtypes.OrderInfo{
+		IsAsk:      tx.IsAsk,
+		BaseAmount: tx.BaseAmount,
+		ReduceOnly: tx.ReduceOnly,
+	}
+	return order.ValidateReduceOnly(position)
+}
+
+// positionAfterFill returns the position held after tx is fully filled.
+// A reduce-only order with a nil BaseAmount closes the whole position.
+func positionAfterFill(tx *types.CreateOrderTxReq, position int64) int64 {
+	if tx.BaseAmount == txtypes.NilOrderBaseAmount {
+		return 0
+	}
+	if tx.IsAsk == 1 {
+		return position - tx.BaseAmount
+	}
+	return position + tx.BaseAmount
+}
+
 func (c *TxClient) GetCreateGroupedOrdersTransaction(tx *types.CreateGroupedOrdersTxReq, ops *types.TransactOpts) (*txtypes.L2CreateGroupedOrdersTxInfo, error) {
+	if c.IsReadOnly() {
+		return nil, ErrSignerRequired
+	}
 	ops, err := c.FullFillDefaultOps(ops)
 	if err != nil {
 		return nil, err
@@ -123,6 +219,9 @@ func (c *TxClient) GetCreateGroupedOrdersTransaction(tx *types.CreateGroupedOrde
 }
 
 func (c *TxClient) GetCancelOrderTransaction(tx *types.CancelOrderTxReq, ops *types.TransactOpts) (*txtypes.L2CancelOrderTxInfo, error) {
+	if c.IsReadOnly() {
+		return nil, ErrSignerRequired
+	}
 	ops, err := c.FullFillDefaultOps(ops)
 	if err != nil {
 		return nil, err
@@ -135,6 +234,9 @@ func (c *TxClient) GetCancelOrderTransaction(tx *types.CancelOrderTxReq, ops *ty
 }
 
 func (c *TxClient) GetModifyOrderTransaction(tx *types.ModifyOrderTxReq, ops *types.TransactOpts) (*txtypes.L2ModifyOrderTxInfo, error) {
+	if c.IsReadOnly() {
+		return nil, ErrSignerRequired
+	}
 	ops, err := c.FullFillDefaultOps(ops)
 	if err != nil {
 		return nil, err
@@ -149,6 +251,9 @@ func (c *TxClient) GetModifyOrderTransaction(tx *types.ModifyOrderTxReq, ops *ty
 }
 
 func (c *TxClient) GetCancelAllOrdersTransaction(tx *types.CancelAllOrdersTxReq, ops *types.TransactOpts) (*txtypes.L2CancelAllOrdersTxInfo, error) {
+	if c.IsReadOnly() {
+		return nil, ErrSignerRequired
+	}
 	ops, err := c.FullFillDefaultOps(ops)
 	if err != nil {
 		return nil, err
@@ -161,6 +266,9 @@ func (c *TxClient) GetCancelAllOrdersTransaction(tx *types.CancelAllOrdersTxReq,
 }
 
 func (c *TxClient) GetMintSharesTransaction(tx *types.MintSharesTxReq, ops *types.TransactOpts) (*txtypes.L2MintSharesTxInfo, error) {
+	if c.IsReadOnly() {
+		return nil, ErrSignerRequired
+	}
 	ops, err := c.FullFillDefaultOps(ops)
 	if err != nil {
 		return nil, err
@@ -173,6 +281,9 @@ func (c *TxClient) GetMintSharesTransaction(tx *types.MintSharesTxReq, ops *type
 }
 
 func (c *TxClient) GetBurnSharesTransaction(tx *types.BurnSharesTxReq, ops *types.TransactOpts) (*txtypes.L2BurnSharesTxInfo, error) {
+	if c.IsReadOnly() {
+		return nil, ErrSignerRequired
+	}
 	ops, err := c.FullFillDefaultOps(ops)
 	if err != nil {
 		return nil, err
@@ -185,6 +296,9 @@ func (c *TxClient) GetBurnSharesTransaction(tx *types.BurnSharesTxReq, ops *type
 }
 
 func (c *TxClient) GetUpdateLeverageTransaction(tx *types.UpdateLeverageTxReq, ops *types.TransactOpts) (*txtypes.L2UpdateLeverageTxInfo, error) {
+	if c.IsReadOnly() {
+		return nil, ErrSignerRequired
+	}
 	ops, err := c.FullFillDefaultOps(ops)
 	if err != nil {
 		return nil, err
@@ -197,6 +311,9 @@ func (c *TxClient) GetUpdateLeverageTransaction(tx *types.UpdateLeverageTxReq, o
 }
 
 func (c *TxClient) GetUpdateMarginTransaction(tx *types.UpdateMarginTxReq, ops *types.TransactOpts) (*txtypes.L2UpdateMarginTxInfo, error) {
+	if c.IsReadOnly() {
+		return nil, ErrSignerRequired
+	}
 	ops, err := c.FullFillDefaultOps(ops)
 	if err != nil {
 		return nil, err
@@ -209,6 +326,9 @@ func (c *TxClient) GetUpdateMarginTransaction(tx *types.UpdateMarginTxReq, ops *
 }
 
 func (c *TxClient) GetStakeAssetsTransaction(tx *types.StakeAssetsTxReq, ops *types.TransactOpts) (*txtypes.L2StakeAssetsTxInfo, error) {
+	if c.IsReadOnly() {
+		return nil, ErrSignerRequired
+	}
 	ops, err := c.FullFillDefaultOps(ops)
 	if err != nil {
 		return nil, err
@@ -221,6 +341,9 @@ func (c *TxClient) GetStakeAssetsTransaction(tx *types.StakeAssetsTxReq, ops *ty
 }
 
 func (c *TxClient) GetUnstakeAssetsTransaction(tx *types.UnstakeAssetsTxReq, ops *types.TransactOpts) (*txtypes.L2UnstakeAssetsTxInfo, error) {
+	if c.IsReadOnly() {
+		return nil, ErrSignerRequired
+	}
 	ops, err := c.FullFillDefaultOps(ops)
 	if err != nil {
 		return nil, err
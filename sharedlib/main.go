@@ -3,6 +3,7 @@ package main
 import (
 	"encoding/hex"
 	"fmt"
+	"sync"
 	"time"
 	"unsafe"
 
@@ -52,6 +53,13 @@ import "C"
 
 var chainId uint32
 
+// httpOptions are applied to the HTTP clients of all clients created after SetClientIdentification is called
+// The shared library is called from arbitrary host threads, so they're guarded by httpOptionsMu
+var (
+	httpOptionsMu sync.Mutex
+	httpOptions   []http.Option
+)
+
 func wrapErr(err any) *C.char {
 	if err == nil {
 		return nil
@@ -151,12 +159,35 @@ func CreateClient(cUrl *C.char, cPrivateKey *C.char, cChainId C.int, cApiKeyInde
 	apiKeyIndex := uint8(cApiKeyIndex)
 	accountIndex := int64(cAccountIndex)
 
-	httpClient := http.NewClient(url)
+	httpOptionsMu.Lock()
+	opts := httpOptions
+	httpOptionsMu.Unlock()
+
+	httpClient := http.NewClient(url, opts...)
 
 	_, err := client.CreateClient(httpClient, privateKey, chainId, apiKeyIndex, accountIndex)
 	return wrapErr(err)
 }
 
+//export SetClientIdentification
+func SetClientIdentification(cAppName *C.char, cDisableTelemetry C.int) (ret *C.char) {
+	defer func() {
+		if r := recover(); r != nil {
+			ret = wrapErr(fmt.Errorf("panic: %v", r))
+		}
+	}()
+
+	opts := []http.Option{http.WithAppName(C.GoString(cAppName))}
+	if cDisableTelemetry != 0 {
+		opts = append(opts, http.WithoutTelemetry())
+	}
+
+	httpOptionsMu.Lock()
+	httpOptions = opts
+	httpOptionsMu.Unlock()
+	return nil
+}
+
 //export CheckClient
 func CheckClient(cApiKeyIndex C.int, cAccountIndex C.longlong) (ret *C.char) {
 	defer func() {
@@ -658,6 +689,27 @@ func CreateAuthToken(cDeadline C.longlong, cApiKeyIndex C.int, cAccountIndex C.l
 	return C.StrOrErr{str: C.CString(authToken)}
 }
 
+//export AuditHash
+func AuditHash(cTxType C.int, cTxInfo *C.char) (ret C.StrOrErr) {
+	defer func() {
+		if r := recover(); r != nil {
+			ret = C.StrOrErr{err: wrapErr(fmt.Errorf("panic: %v", r))}
+		}
+	}()
+
+	txInfo, err := txtypes.ParseTxInfo(uint8(cTxType), C.GoString(cTxInfo))
+	if err != nil {
+		return C.StrOrErr{err: wrapErr(err)}
+	}
+
+	auditHash, err := txInfo.AuditHash()
+	if err != nil {
+		return C.StrOrErr{err: wrapErr(err)}
+	}
+
+	return C.StrOrErr{str: C.CString(auditHash)}
+}
+
 //export SignUpdateMargin
 func SignUpdateMargin(cMarketIndex C.int, cUSDCAmount C.longlong, cDirection C.int, cNonce C.longlong, cApiKeyIndex C.int, cAccountIndex C.longlong) (ret C.SignedTxResponse) {
 	defer func() {
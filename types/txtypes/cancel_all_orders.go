@@ -32,6 +32,17 @@ func (txInfo *L2CancelAllOrdersTxInfo) GetTxHash() string {
 	return txInfo.SignedHash
 }
 
+func (txInfo *L2CancelAllOrdersTxInfo) GetCanonicalTxInfo() ([]byte, error) {
+	if txInfo == nil {
+		return nil, ErrTxInfoNil
+	}
+	return getCanonicalTxInfo(txInfo, txInfo.Sig)
+}
+
+func (txInfo *L2CancelAllOrdersTxInfo) AuditHash() (string, error) {
+	return getAuditHash(txInfo)
+}
+
 func (txInfo *L2CancelAllOrdersTxInfo) Validate() error {
 	// AccountIndex
 	if txInfo.AccountIndex < MinAccountIndex {
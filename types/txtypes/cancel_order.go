@@ -32,6 +32,17 @@ func (txInfo *L2CancelOrderTxInfo) GetTxHash() string {
 	return txInfo.SignedHash
 }
 
+func (txInfo *L2CancelOrderTxInfo) GetCanonicalTxInfo() ([]byte, error) {
+	if txInfo == nil {
+		return nil, ErrTxInfoNil
+	}
+	return getCanonicalTxInfo(txInfo, txInfo.Sig)
+}
+
+func (txInfo *L2CancelOrderTxInfo) AuditHash() (string, error) {
+	return getAuditHash(txInfo)
+}
+
 func (txInfo *L2CancelOrderTxInfo) Validate() error {
 	// AccountIndex
 	if txInfo.AccountIndex < MinAccountIndex {
@@ -35,6 +35,17 @@ func (txInfo *L2ChangePubKeyTxInfo) GetTxHash() string {
 	return txInfo.SignedHash
 }
 
+func (txInfo *L2ChangePubKeyTxInfo) GetCanonicalTxInfo() ([]byte, error) {
+	if txInfo == nil {
+		return nil, ErrTxInfoNil
+	}
+	return getCanonicalTxInfo(txInfo, txInfo.Sig)
+}
+
+func (txInfo *L2ChangePubKeyTxInfo) AuditHash() (string, error) {
+	return getAuditHash(txInfo)
+}
+
 func (txInfo *L2ChangePubKeyTxInfo) Validate() error {
 	// AccountIndex
 	if txInfo.AccountIndex < MinAccountIndex {
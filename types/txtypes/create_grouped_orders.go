@@ -34,6 +34,17 @@ func (txInfo *L2CreateGroupedOrdersTxInfo) GetTxHash() string {
 	return txInfo.SignedHash
 }
 
+func (txInfo *L2CreateGroupedOrdersTxInfo) GetCanonicalTxInfo() ([]byte, error) {
+	if txInfo == nil {
+		return nil, ErrTxInfoNil
+	}
+	return getCanonicalTxInfo(txInfo, txInfo.Sig)
+}
+
+func (txInfo *L2CreateGroupedOrdersTxInfo) AuditHash() (string, error) {
+	return getAuditHash(txInfo)
+}
+
 func (txInfo *L2CreateGroupedOrdersTxInfo) Validate() error {
 	// AccountIndex
 	if txInfo.AccountIndex < MinAccountIndex {
@@ -31,6 +31,17 @@ func (txInfo *L2CreateOrderTxInfo) GetTxHash() string {
 	return txInfo.SignedHash
 }
 
+func (txInfo *L2CreateOrderTxInfo) GetCanonicalTxInfo() ([]byte, error) {
+	if txInfo == nil {
+		return nil, ErrTxInfoNil
+	}
+	return getCanonicalTxInfo(txInfo, txInfo.Sig)
+}
+
+func (txInfo *L2CreateOrderTxInfo) AuditHash() (string, error) {
+	return getAuditHash(txInfo)
+}
+
 func (txInfo *L2CreateOrderTxInfo) Validate() error {
 	// AccountIndex
 	if txInfo.AccountIndex < MinAccountIndex {
@@ -190,3 +201,28 @@ func (txInfo *L2CreateOrderTxInfo) Hash(lighterChainId uint32, extra ...g.Elemen
 
 	return p2.HashToQuinticExtension(elems).ToLittleEndianBytes(), nil
 }
+
+// ValidateReduceOnly checks a reduce-only order against position, the signed base amount currently held in the order's market
+// (positive for long, negative for short). Reduce-only orders can only decrease exposure, so they're rejected when there's
+// no position to reduce, when they're on the same side as the position, or when their BaseAmount is larger than the position.
+// A nil BaseAmount closes the whole position, so it's not checked against the size. Non reduce-only orders are not checked.
+func (order *OrderInfo) ValidateReduceOnly(position int64) error {
+	if order.ReduceOnly != 1 {
+		return nil
+	}
+	if order.IsAsk == 1 && position <= 0 {
+		return ErrReduceOnlyIncreasesPosition
+	}
+	if order.IsAsk == 0 && position >= 0 {
+		return ErrReduceOnlyIncreasesPosition
+	}
+
+	size := position
+	if size < 0 {
+		size = -size
+	}
+	if order.BaseAmount != NilOrderBaseAmount && order.BaseAmount > size {
+		return ErrReduceOnlyIncreasesPosition
+	}
+	return nil
+}
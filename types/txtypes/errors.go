@@ -11,6 +11,7 @@ var (
 	ErrNonceTooLow                     = fmt.Errorf("AccountNonce should not be less than %d", MinNonce)
 	ErrInvalidCancelAllTimeInForce     = fmt.Errorf("CancelAllTimeInForce is invalid")
 	ErrOrderReduceOnlyInvalid          = fmt.Errorf("ReduceOnly is invalid")
+	ErrReduceOnlyIncreasesPosition     = fmt.Errorf("ReduceOnly order would increase the position")
 	ErrOrderTriggerPriceInvalid        = fmt.Errorf("TriggerPrice is invalid")
 	ErrOrderExpiryInvalid              = fmt.Errorf("OrderExpiry is invalid")
 	ErrExpiredAtInvalid                = fmt.Errorf("ExpiredAt is invalid")
@@ -70,6 +71,9 @@ var (
 	ErrGroupingTypeInvalid             = fmt.Errorf("GroupingType is not valid")
 	ErrOrderGroupSizeInvalid           = fmt.Errorf("OrderGroupSize is not valid")
 	ErrInvalidSignature                = fmt.Errorf("TxSignature is invalid")
+	ErrTxInfoNil                       = fmt.Errorf("TxInfo is nil")
+	ErrTxNotSigned                     = fmt.Errorf("Tx is not signed")
+	ErrTxTypeUnsupported               = fmt.Errorf("TxType is not supported")
 	ErrInvalidMarginMode               = fmt.Errorf("MarginMode is not valid")
 	ErrCancelModeInvalid               = fmt.Errorf("CancelMode is not valid")
 	ErrInvalidUpdateMarginDirection    = fmt.Errorf("Margin movement direction is not valid")
@@ -15,6 +15,16 @@ type TxInfo interface {
 	// Returns empty string if the Tx is not signed.
 	GetTxHash() string
 
+	// GetCanonicalTxInfo returns the full signed payload (including Sig and L1Sig) as JSON with sorted keys
+	// and integers written in plain decimal form. Unlike GetTxInfo, the output doesn't depend on the struct field order.
+	// The encoding rules are specified in the README, under "Audit hash", so it can be recomputed outside of go.
+	GetCanonicalTxInfo() ([]byte, error)
+
+	// AuditHash returns the lowercase hex-encoded SHA-256 of GetCanonicalTxInfo.
+	// It fingerprints the exact transaction emitted by the SDK, signatures included, so it can be stored tamper-evidently.
+	// It is not the TxHash used by Lighter; use GetTxHash for that.
+	AuditHash() (string, error)
+
 	Validate() error
 
 	Hash(lighterChainId uint32, extra ...g.Element) (msgHash []byte, err error)
@@ -32,6 +32,17 @@ func (txInfo *L2MintSharesTxInfo) GetTxHash() string {
 	return txInfo.SignedHash
 }
 
+func (txInfo *L2MintSharesTxInfo) GetCanonicalTxInfo() ([]byte, error) {
+	if txInfo == nil {
+		return nil, ErrTxInfoNil
+	}
+	return getCanonicalTxInfo(txInfo, txInfo.Sig)
+}
+
+func (txInfo *L2MintSharesTxInfo) AuditHash() (string, error) {
+	return getAuditHash(txInfo)
+}
+
 func (txInfo *L2MintSharesTxInfo) Validate() error {
 	if txInfo.AccountIndex < MinAccountIndex {
 		return ErrFromAccountIndexTooLow
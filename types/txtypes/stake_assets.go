@@ -32,6 +32,17 @@ func (txInfo *L2StakeAssetsTxInfo) GetTxHash() string {
 	return txInfo.SignedHash
 }
 
+func (txInfo *L2StakeAssetsTxInfo) GetCanonicalTxInfo() ([]byte, error) {
+	if txInfo == nil {
+		return nil, ErrTxInfoNil
+	}
+	return getCanonicalTxInfo(txInfo, txInfo.Sig)
+}
+
+func (txInfo *L2StakeAssetsTxInfo) AuditHash() (string, error) {
+	return getAuditHash(txInfo)
+}
+
 func (txInfo *L2StakeAssetsTxInfo) Validate() error {
 	if txInfo.AccountIndex < MinAccountIndex {
 		return ErrFromAccountIndexTooLow
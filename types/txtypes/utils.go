@@ -1,6 +1,9 @@
 package txtypes
 
 import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"strings"
@@ -46,6 +49,87 @@ func getTxInfo(tx interface{}) (string, error) {
 	return string(txInfoBytes), nil
 }
 
+func getCanonicalTxInfo(tx interface{}, sig []byte) ([]byte, error) {
+	// only signed payloads are fingerprinted, as the hash of an unsigned tx doesn't identify anything that was emitted
+	if len(sig) == 0 {
+		return nil, ErrTxNotSigned
+	}
+
+	txInfoBytes, err := json.Marshal(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	// decode into generic maps, so keys get sorted on re-encoding, while keeping numbers as they were written
+	decoder := json.NewDecoder(bytes.NewReader(txInfoBytes))
+	decoder.UseNumber()
+	var canonical interface{}
+	if err := decoder.Decode(&canonical); err != nil {
+		return nil, err
+	}
+	return json.Marshal(canonical)
+}
+
+func getAuditHash(tx TxInfo) (string, error) {
+	canonical, err := tx.GetCanonicalTxInfo()
+	if err != nil {
+		return "", err
+	}
+	hash := sha256.Sum256(canonical)
+	return hex.EncodeToString(hash[:]), nil
+}
+
+// ParseTxInfo decodes txInfo, as returned by TxInfo.GetTxInfo, into the transaction type matching txType.
+// It allows computing the AuditHash of transactions which were signed outside of go, e.g. by the sharedlib.
+func ParseTxInfo(txType uint8, txInfo string) (TxInfo, error) {
+	var tx TxInfo
+	switch txType {
+	case TxTypeL2ChangePubKey:
+		tx = &L2ChangePubKeyTxInfo{}
+	case TxTypeL2CreateSubAccount:
+		tx = &L2CreateSubAccountTxInfo{}
+	case TxTypeL2CreatePublicPool:
+		tx = &L2CreatePublicPoolTxInfo{}
+	case TxTypeL2UpdatePublicPool:
+		tx = &L2UpdatePublicPoolTxInfo{}
+	case TxTypeL2Transfer:
+		tx = &L2TransferTxInfo{}
+	case TxTypeL2Withdraw:
+		tx = &L2WithdrawTxInfo{}
+	case TxTypeL2CreateOrder:
+		tx = &L2CreateOrderTxInfo{}
+	case TxTypeL2CancelOrder:
+		tx = &L2CancelOrderTxInfo{}
+	case TxTypeL2CancelAllOrders:
+		tx = &L2CancelAllOrdersTxInfo{}
+	case TxTypeL2ModifyOrder:
+		tx = &L2ModifyOrderTxInfo{}
+	case TxTypeL2MintShares:
+		tx = &L2MintSharesTxInfo{}
+	case TxTypeL2BurnShares:
+		tx = &L2BurnSharesTxInfo{}
+	case TxTypeL2UpdateLeverage:
+		tx = &L2UpdateLeverageTxInfo{}
+	case TxTypeL2CreateGroupedOrders:
+		tx = &L2CreateGroupedOrdersTxInfo{}
+	case TxTypeL2UpdateMargin:
+		tx = &L2UpdateMarginTxInfo{}
+	case TxTypeL2StakeAssets:
+		tx = &L2StakeAssetsTxInfo{}
+	case TxTypeL2UnstakeAssets:
+		tx = &L2UnstakeAssetsTxInfo{}
+	default:
+		return nil, ErrTxTypeUnsupported
+	}
+
+	decoder := json.NewDecoder(strings.NewReader(txInfo))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(tx); err != nil {
+		return nil, err
+	}
+	return tx, nil
+}
+
 func getHex10FromUint64(value uint64) string {
 	v := hexutil.EncodeUint64(value)
 	v = strings.Replace(v, "0x", "", 1)
@@ -16,6 +16,9 @@ import (
 	"github.com/ethereum/go-ethereum/common/hexutil"
 )
 
+// httpOptions are applied to the HTTP clients of all clients created after SetClientIdentification is called
+var httpOptions []http.Option
+
 func wrapErr(err error) js.Value {
 	if err != nil {
 		return js.ValueOf(map[string]interface{}{"error": fmt.Sprintf("%v", err)})
@@ -47,10 +50,16 @@ func convertTxInfoToJS(info txtypes.TxInfo, err error) js.Value {
 		return wrapErr(strErr)
 	}
 
+	auditHash, hashErr := info.AuditHash()
+	if hashErr != nil {
+		return wrapErr(hashErr)
+	}
+
 	out := map[string]interface{}{
-		"txType": info.GetTxType(),
-		"txInfo": txInfoStr,
-		"txHash": info.GetTxHash(),
+		"txType":    info.GetTxType(),
+		"txInfo":    txInfoStr,
+		"txHash":    info.GetTxHash(),
+		"auditHash": auditHash,
 	}
 	if msg := messageToSign(info); msg != "" {
 		out["messageToSign"] = msg
@@ -137,7 +146,7 @@ func main() {
 			chainId := uint32(args[2].Int())
 			apiKeyIndex := uint8(args[3].Int())
 			accountIndex := int64(args[4].Int())
-			httpClient := http.NewClient(url)
+			httpClient := http.NewClient(url, httpOptions...)
 			_, err := client.CreateClient(httpClient, privateKey, chainId, apiKeyIndex, accountIndex)
 			if err != nil {
 				return wrapErr(err)
@@ -146,6 +155,19 @@ func main() {
 		})
 	}))
 
+	js.Global().Set("SetClientIdentification", js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+		return recoverPanic(func() js.Value {
+			if len(args) < 2 {
+				return js.ValueOf(map[string]interface{}{"error": "SetClientIdentification expects 2 args: appName, disableTelemetry"})
+			}
+			httpOptions = []http.Option{http.WithAppName(args[0].String())}
+			if args[1].Truthy() {
+				httpOptions = append(httpOptions, http.WithoutTelemetry())
+			}
+			return wrapErr(nil)
+		})
+	}))
+
 	js.Global().Set("CheckClient", js.FuncOf(func(this js.Value, args []js.Value) interface{} {
 		return recoverPanic(func() js.Value {
 			if len(args) < 2 {